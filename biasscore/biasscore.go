@@ -0,0 +1,221 @@
+// Package biasscore assigns a reproducible, CVSS-v2-style severity score
+// to a detected bias/discrimination finding, so that a scanner flagging
+// patterns such as the race check in approveLoan (see
+// test-samples/vulnerable/go/bias_detection.go) can rank them consistently
+// instead of leaving severity to reviewer judgment.
+package biasscore
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ProtectedClass describes how many protected attributes a finding
+// conditions its decision on.
+type ProtectedClass string
+
+const (
+	ProtectedClassNone           ProtectedClass = "N" // no protected attribute involved
+	ProtectedClassSingle         ProtectedClass = "S" // one protected attribute
+	ProtectedClassIntersectional ProtectedClass = "I" // two or more, compounding
+)
+
+var protectedClassWeight = map[ProtectedClass]float64{
+	ProtectedClassNone:           0,
+	ProtectedClassSingle:         0.5,
+	ProtectedClassIntersectional: 0.9,
+}
+
+// DecisionStakes describes what kind of outcome the decision controls.
+type DecisionStakes string
+
+const (
+	DecisionStakesInformational DecisionStakes = "I" // no material effect on the subject
+	DecisionStakesHigh          DecisionStakes = "H" // financial, employment, or housing
+	DecisionStakesLifeSafety    DecisionStakes = "L" // life-safety critical
+)
+
+var decisionStakesWeight = map[DecisionStakes]float64{
+	DecisionStakesInformational: 0.3,
+	DecisionStakesHigh:          0.9,
+	DecisionStakesLifeSafety:    1.0,
+}
+
+// Reversibility describes how hard it is to undo the decision's effect.
+type Reversibility string
+
+const (
+	ReversibilityReversible    Reversibility = "R"
+	ReversibilityHardToReverse Reversibility = "H"
+	ReversibilityIrreversible  Reversibility = "I"
+)
+
+var reversibilityWeight = map[Reversibility]float64{
+	ReversibilityReversible:    0.3,
+	ReversibilityHardToReverse: 0.7,
+	ReversibilityIrreversible:  1.0,
+}
+
+// Directness describes how directly the finding conditions on the
+// protected attribute, versus a correlated proxy for it.
+type Directness string
+
+const (
+	DirectnessProxy    Directness = "P" // e.g. ZIP code standing in for race
+	DirectnessIndirect Directness = "I" // derived from the attribute, not read verbatim
+	DirectnessDirect   Directness = "D" // the attribute is read and branched on directly
+)
+
+var directnessWeight = map[Directness]float64{
+	DirectnessProxy:    0.4,
+	DirectnessIndirect: 0.7,
+	DirectnessDirect:   1.0,
+}
+
+// Coverage describes how much of the system the finding affects.
+type Coverage string
+
+const (
+	CoverageScoped   Coverage = "S" // a single function or decision path
+	CoverageSystemic Coverage = "G" // applies system-wide
+)
+
+var coverageWeight = map[Coverage]float64{
+	CoverageScoped:   0.5,
+	CoverageSystemic: 1.0,
+}
+
+// HumanReview describes whether a human can catch the biased decision
+// before it takes effect.
+type HumanReview string
+
+const (
+	HumanReviewRequired HumanReview = "R"
+	HumanReviewOptional HumanReview = "O"
+	HumanReviewNone     HumanReview = "N"
+)
+
+var humanReviewWeight = map[HumanReview]float64{
+	HumanReviewRequired: 0.3,
+	HumanReviewOptional: 0.7,
+	HumanReviewNone:     1.0,
+}
+
+// Finding describes one detected bias/discrimination pattern along the six
+// factors that determine its severity.
+type Finding struct {
+	ProtectedClass ProtectedClass
+	DecisionStakes DecisionStakes
+	Reversibility  Reversibility
+	Directness     Directness
+	Coverage       Coverage
+	HumanReview    HumanReview
+}
+
+// Result is a finding's computed severity, kept alongside the sub-scores
+// and vector string it was derived from so the score stays explainable.
+type Result struct {
+	Base           float64 `json:"base"`
+	Impact         float64 `json:"impact"`
+	Exploitability float64 `json:"exploitability"`
+	Vector         string  `json:"vector"`
+}
+
+// Score computes a finding's severity (0.0-10.0) via a CVSS-v2-style
+// decomposition into an Impact sub-score (ProtectedClass, DecisionStakes,
+// Reversibility) and an Exploitability sub-score (Directness, Coverage,
+// HumanReview).
+func Score(f Finding) Result {
+	pc := protectedClassWeight[f.ProtectedClass]
+	ds := decisionStakesWeight[f.DecisionStakes]
+	r := reversibilityWeight[f.Reversibility]
+	impact := 10.41 * (1 - (1-pc)*(1-ds)*(1-r))
+
+	d := directnessWeight[f.Directness]
+	c := coverageWeight[f.Coverage]
+	hr := humanReviewWeight[f.HumanReview]
+	exploit := 20 * d * c * hr
+
+	fImpact := 1.176
+	if impact == 0 {
+		fImpact = 0
+	}
+	base := round1(((0.6 * impact) + (0.4 * exploit) - 1.5) * fImpact)
+	if base < 0 {
+		base = 0
+	}
+	if base > 10 {
+		base = 10
+	}
+
+	return Result{
+		Base:           base,
+		Impact:         round1(impact),
+		Exploitability: round1(exploit),
+		Vector:         f.Vector(),
+	}
+}
+
+// Vector renders a finding as a CVSS-style vector string, e.g.
+// "PC:I/DS:H/R:I/D:D/C:S/HR:N". Parse reverses this.
+func (f Finding) Vector() string {
+	return fmt.Sprintf("PC:%s/DS:%s/R:%s/D:%s/C:%s/HR:%s",
+		f.ProtectedClass, f.DecisionStakes, f.Reversibility, f.Directness, f.Coverage, f.HumanReview)
+}
+
+// Parse parses a vector string produced by Finding.Vector back into a
+// Finding, validating that every metric and value is recognized.
+func Parse(vector string) (Finding, error) {
+	values := map[string]string{}
+	for _, part := range strings.Split(vector, "/") {
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			return Finding{}, fmt.Errorf("biasscore: malformed vector segment %q", part)
+		}
+		switch kv[0] {
+		case "PC", "DS", "R", "D", "C", "HR":
+		default:
+			return Finding{}, fmt.Errorf("biasscore: unknown metric %q", kv[0])
+		}
+		values[kv[0]] = kv[1]
+	}
+
+	pc := ProtectedClass(values["PC"])
+	if _, ok := protectedClassWeight[pc]; !ok {
+		return Finding{}, fmt.Errorf("biasscore: unknown ProtectedClass %q", values["PC"])
+	}
+	ds := DecisionStakes(values["DS"])
+	if _, ok := decisionStakesWeight[ds]; !ok {
+		return Finding{}, fmt.Errorf("biasscore: unknown DecisionStakes %q", values["DS"])
+	}
+	r := Reversibility(values["R"])
+	if _, ok := reversibilityWeight[r]; !ok {
+		return Finding{}, fmt.Errorf("biasscore: unknown Reversibility %q", values["R"])
+	}
+	d := Directness(values["D"])
+	if _, ok := directnessWeight[d]; !ok {
+		return Finding{}, fmt.Errorf("biasscore: unknown Directness %q", values["D"])
+	}
+	c := Coverage(values["C"])
+	if _, ok := coverageWeight[c]; !ok {
+		return Finding{}, fmt.Errorf("biasscore: unknown Coverage %q", values["C"])
+	}
+	hr := HumanReview(values["HR"])
+	if _, ok := humanReviewWeight[hr]; !ok {
+		return Finding{}, fmt.Errorf("biasscore: unknown HumanReview %q", values["HR"])
+	}
+
+	return Finding{
+		ProtectedClass: pc,
+		DecisionStakes: ds,
+		Reversibility:  r,
+		Directness:     d,
+		Coverage:       c,
+		HumanReview:    hr,
+	}, nil
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
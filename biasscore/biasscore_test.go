@@ -0,0 +1,116 @@
+package biasscore
+
+import "testing"
+
+func TestScoreApproveLoanRaceCheck(t *testing.T) {
+	// Direct, unreviewed race discrimination in a single loan-approval
+	// function: PC single, financial stakes, irreversible (the applicant
+	// is never told why they were denied), direct use, scoped to one
+	// function, no human review.
+	f := Finding{
+		ProtectedClass: ProtectedClassSingle,
+		DecisionStakes: DecisionStakesHigh,
+		Reversibility:  ReversibilityIrreversible,
+		Directness:     DirectnessDirect,
+		Coverage:       CoverageScoped,
+		HumanReview:    HumanReviewNone,
+	}
+
+	result := Score(f)
+	if result.Base <= 0 {
+		t.Fatalf("expected a positive severity for direct race discrimination, got %v", result.Base)
+	}
+	if result.Base > 10 {
+		t.Fatalf("base score must not exceed 10.0, got %v", result.Base)
+	}
+}
+
+func TestScoreLowestRiskFindingScoresLow(t *testing.T) {
+	f := Finding{
+		ProtectedClass: ProtectedClassNone,
+		DecisionStakes: DecisionStakesInformational,
+		Reversibility:  ReversibilityReversible,
+		Directness:     DirectnessProxy,
+		Coverage:       CoverageScoped,
+		HumanReview:    HumanReviewRequired,
+	}
+
+	result := Score(f)
+	if result.Base <= 0 || result.Base > 3 {
+		t.Fatalf("expected the lowest-risk combination to score low but positive, got %v", result.Base)
+	}
+}
+
+func TestBaseIsZeroOnlyWhenImpactIsZero(t *testing.T) {
+	// Impact can only be exactly zero when every impact factor is at its
+	// floor; none of the current enums reach that, so f(Impact) always
+	// takes the 1.176 branch in practice. Check that the lowest-impact
+	// combination still has a nonzero impact and therefore a nonzero base.
+	result := Score(Finding{
+		ProtectedClass: ProtectedClassNone,
+		DecisionStakes: DecisionStakesInformational,
+		Reversibility:  ReversibilityReversible,
+		Directness:     DirectnessDirect,
+		Coverage:       CoverageSystemic,
+		HumanReview:    HumanReviewNone,
+	})
+	if result.Impact == 0 {
+		t.Fatal("expected nonzero impact for informational/reversible stakes")
+	}
+	if result.Base == 0 {
+		t.Fatalf("expected nonzero base when impact is nonzero (impact=%v)", result.Impact)
+	}
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	want := Finding{
+		ProtectedClass: ProtectedClassIntersectional,
+		DecisionStakes: DecisionStakesHigh,
+		Reversibility:  ReversibilityIrreversible,
+		Directness:     DirectnessDirect,
+		Coverage:       CoverageScoped,
+		HumanReview:    HumanReviewNone,
+	}
+
+	vector := want.Vector()
+	if vector != "PC:I/DS:H/R:I/D:D/C:S/HR:N" {
+		t.Fatalf("unexpected vector string: %s", vector)
+	}
+
+	got, err := Parse(vector)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("Parse(vector) = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseRejectsUnknownMetricValue(t *testing.T) {
+	if _, err := Parse("PC:X/DS:H/R:I/D:D/C:S/HR:N"); err == nil {
+		t.Fatal("expected an error for an unrecognized ProtectedClass value")
+	}
+}
+
+func TestHigherStakesIncreaseSeverity(t *testing.T) {
+	low := Score(Finding{
+		ProtectedClass: ProtectedClassSingle,
+		DecisionStakes: DecisionStakesInformational,
+		Reversibility:  ReversibilityReversible,
+		Directness:     DirectnessDirect,
+		Coverage:       CoverageScoped,
+		HumanReview:    HumanReviewRequired,
+	})
+	high := Score(Finding{
+		ProtectedClass: ProtectedClassSingle,
+		DecisionStakes: DecisionStakesLifeSafety,
+		Reversibility:  ReversibilityIrreversible,
+		Directness:     DirectnessDirect,
+		Coverage:       CoverageSystemic,
+		HumanReview:    HumanReviewNone,
+	})
+
+	if high.Base <= low.Base {
+		t.Fatalf("expected higher-stakes finding to score higher: low=%v high=%v", low.Base, high.Base)
+	}
+}
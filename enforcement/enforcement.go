@@ -0,0 +1,87 @@
+// Package enforcement lets a single rule declare different enforcement
+// actions for different runtime scopes (Gatekeeper's scoped enforcement
+// actions), so a new fairness/ethics rule can warn in an audit pipeline
+// today and start denying in CI tomorrow without duplicating the rule
+// itself.
+package enforcement
+
+import "sync"
+
+// Action is what happens when a rule matches in a given scope.
+type Action string
+
+const (
+	ActionWarn   Action = "warn"   // record the match but allow it through
+	ActionDeny   Action = "deny"   // block the call the rule matched on
+	ActionDryRun Action = "dryrun" // evaluate and record, take no action
+)
+
+// ScopedAction pins one Action to one runtime scope, e.g. "ci" or
+// "webhook".
+type ScopedAction struct {
+	Scope  string
+	Action Action
+}
+
+// RuleEnforcement is a rule's enforcement policy: the action it takes in
+// each scope it has an opinion about, plus a fallback for scopes it
+// doesn't mention.
+type RuleEnforcement struct {
+	RuleID        string
+	ScopedActions []ScopedAction
+	DefaultAction Action
+}
+
+// ResolveAction returns the Action a rule's enforcement policy selects for
+// runtimeScope, falling back to rule.DefaultAction when the scope has no
+// explicit entry.
+func ResolveAction(rule RuleEnforcement, runtimeScope string) Action {
+	for _, sa := range rule.ScopedActions {
+		if sa.Scope == runtimeScope {
+			return sa.Action
+		}
+	}
+	return rule.DefaultAction
+}
+
+// Counters tallies how many times each scope has resolved to each action,
+// so an audit report can show, for example, that a rule is warning ten
+// times a day in production while it would have denied three of those in
+// CI.
+type Counters struct {
+	mu     sync.Mutex
+	counts map[string]map[Action]int
+}
+
+// NewCounters returns an empty, ready-to-use Counters.
+func NewCounters() *Counters {
+	return &Counters{counts: map[string]map[Action]int{}}
+}
+
+// Record increments the tally for one scope/action pair.
+func (c *Counters) Record(scope string, action Action) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	byAction, ok := c.counts[scope]
+	if !ok {
+		byAction = map[Action]int{}
+		c.counts[scope] = byAction
+	}
+	byAction[action]++
+}
+
+// Snapshot returns a copy of the current tallies, safe to read or
+// serialize without racing further calls to Record.
+func (c *Counters) Snapshot() map[string]map[Action]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make(map[string]map[Action]int, len(c.counts))
+	for scope, byAction := range c.counts {
+		copyByAction := make(map[Action]int, len(byAction))
+		for action, n := range byAction {
+			copyByAction[action] = n
+		}
+		out[scope] = copyByAction
+	}
+	return out
+}
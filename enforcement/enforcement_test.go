@@ -0,0 +1,51 @@
+package enforcement
+
+import "testing"
+
+func TestResolveActionUsesScopedOverride(t *testing.T) {
+	rule := RuleEnforcement{
+		RuleID: "uses-protected-attribute",
+		ScopedActions: []ScopedAction{
+			{Scope: "audit", Action: ActionWarn},
+			{Scope: "ci", Action: ActionDeny},
+			{Scope: "webhook", Action: ActionDryRun},
+		},
+		DefaultAction: ActionWarn,
+	}
+
+	cases := []struct {
+		scope string
+		want  Action
+	}{
+		{"audit", ActionWarn},
+		{"ci", ActionDeny},
+		{"webhook", ActionDryRun},
+		{"unmapped-scope", ActionWarn},
+	}
+
+	for _, c := range cases {
+		if got := ResolveAction(rule, c.scope); got != c.want {
+			t.Errorf("ResolveAction(%q) = %q, want %q", c.scope, got, c.want)
+		}
+	}
+}
+
+func TestCountersRecordAndSnapshot(t *testing.T) {
+	counters := NewCounters()
+	counters.Record("ci", ActionDeny)
+	counters.Record("ci", ActionDeny)
+	counters.Record("audit", ActionWarn)
+
+	snapshot := counters.Snapshot()
+	if snapshot["ci"][ActionDeny] != 2 {
+		t.Errorf("expected 2 ci/deny counts, got %d", snapshot["ci"][ActionDeny])
+	}
+	if snapshot["audit"][ActionWarn] != 1 {
+		t.Errorf("expected 1 audit/warn count, got %d", snapshot["audit"][ActionWarn])
+	}
+
+	snapshot["ci"][ActionDeny] = 99
+	if counters.Snapshot()["ci"][ActionDeny] != 2 {
+		t.Error("Snapshot must return a copy, not the live map")
+	}
+}
@@ -0,0 +1,228 @@
+// Package fairness computes group-fairness measures over a decision audit
+// trail such as the one kept by TransparentPredictor in the ai_ethics
+// examples. Callers never need to store protected attributes (race,
+// gender, age, ...) alongside the decision input itself: a
+// ProtectedAttributeExtractor maps each record to its group(s) out of
+// band, typically by joining on an identifier the input already carries.
+package fairness
+
+// PersonData is the decision input a Metrics run is computed over. It
+// mirrors the PersonData captured by the audit log and intentionally
+// carries no protected attributes.
+type PersonData struct {
+	Name        string
+	Income      float64
+	CreditScore int
+}
+
+// GroundTruth is the actual outcome for a decision, attached once it
+// becomes known, and is required for EqualOpportunity, FalsePositiveRate
+// (together, equalized odds) and PredictiveParity.
+type GroundTruth struct {
+	Positive bool
+}
+
+// DecisionLog is one recorded decision together with the input that
+// produced it and, if known, the ground truth outcome.
+type DecisionLog struct {
+	Input       PersonData
+	Decision    string
+	GroundTruth *GroundTruth
+}
+
+// ProtectedAttributeExtractor maps a decision's input to the protected
+// group(s) it belongs to, e.g. {"race": "minority", "gender": "female"}.
+type ProtectedAttributeExtractor func(PersonData) map[string]string
+
+// ApprovedDecision is the DecisionLog.Decision value treated as the
+// favorable outcome by every metric in this package.
+const ApprovedDecision = "APPROVED"
+
+// DefaultDisparityThreshold is the four-fifths rule: a selection-rate
+// ratio below this is conventionally treated as evidence of adverse impact.
+const DefaultDisparityThreshold = 0.8
+
+// GroupRate is one group's observed rate for a metric.
+type GroupRate struct {
+	Group string  `json:"group"`
+	Rate  float64 `json:"rate"`
+	Count int     `json:"count"`
+}
+
+// Disparity compares two groups' rates for a metric and flags whether the
+// ratio of the lower rate to the higher rate falls below Threshold.
+type Disparity struct {
+	GroupA    string  `json:"group_a"`
+	GroupB    string  `json:"group_b"`
+	Ratio     float64 `json:"ratio"`
+	Threshold float64 `json:"threshold"`
+	Flagged   bool    `json:"flagged"`
+}
+
+// Metrics computes group-fairness measures for one protected attribute
+// (e.g. "race") at a time over a batch of decisions.
+type Metrics struct {
+	Extractor          ProtectedAttributeExtractor
+	DisparityThreshold float64
+}
+
+// NewMetrics builds a Metrics using the four-fifths rule as the default
+// disparity threshold. Set DisparityThreshold on the returned value to
+// override it.
+func NewMetrics(extractor ProtectedAttributeExtractor) *Metrics {
+	return &Metrics{Extractor: extractor, DisparityThreshold: DefaultDisparityThreshold}
+}
+
+func (m *Metrics) threshold() float64 {
+	if m.DisparityThreshold <= 0 {
+		return DefaultDisparityThreshold
+	}
+	return m.DisparityThreshold
+}
+
+// Report bundles every fairness metric computed for one protected
+// attribute across a decision log. It is safe to marshal to JSON for
+// inclusion in an audit report.
+type Report struct {
+	Attribute           string      `json:"attribute"`
+	DemographicParity   []GroupRate `json:"demographic_parity"`
+	DisparateImpact     []Disparity `json:"disparate_impact"`
+	EqualOpportunity    []GroupRate `json:"equal_opportunity,omitempty"`
+	EqualOpportunityGap []Disparity `json:"equal_opportunity_gap,omitempty"`
+
+	// FalsePositiveRate is the approval rate among actual negatives
+	// (GroundTruth.Positive == false) per group. Equalized odds holds
+	// when both this and EqualOpportunity (the true-positive rate) are
+	// balanced across groups, so the two are reported together.
+	FalsePositiveRate    []GroupRate `json:"false_positive_rate,omitempty"`
+	FalsePositiveRateGap []Disparity `json:"false_positive_rate_gap,omitempty"`
+
+	PredictiveParity    []GroupRate `json:"predictive_parity,omitempty"`
+	PredictiveParityGap []Disparity `json:"predictive_parity_gap,omitempty"`
+}
+
+type groupTally struct {
+	total                    int
+	approved                 int
+	actualPositive           int
+	actualPositiveApproved   int
+	actualNegative           int
+	actualNegativeApproved   int
+	predictedPositive        int
+	predictedPositiveCorrect int
+}
+
+// Compute runs demographic parity and disparate impact over logs for the
+// given protected attribute, plus equal opportunity, false positive rate
+// (together, equalized odds) and predictive parity whenever at least one
+// log carries GroundTruth.
+func (m *Metrics) Compute(attribute string, logs []DecisionLog) Report {
+	tallies := map[string]*groupTally{}
+	order := []string{}
+	haveGroundTruth := false
+
+	for _, l := range logs {
+		groups := m.Extractor(l.Input)
+		group, ok := groups[attribute]
+		if !ok {
+			continue
+		}
+		t, ok := tallies[group]
+		if !ok {
+			t = &groupTally{}
+			tallies[group] = t
+			order = append(order, group)
+		}
+		t.total++
+		approved := l.Decision == ApprovedDecision
+		if approved {
+			t.approved++
+		}
+		if l.GroundTruth != nil {
+			haveGroundTruth = true
+			if l.GroundTruth.Positive {
+				t.actualPositive++
+				if approved {
+					t.actualPositiveApproved++
+				}
+			} else {
+				t.actualNegative++
+				if approved {
+					t.actualNegativeApproved++
+				}
+			}
+			if approved {
+				t.predictedPositive++
+				if l.GroundTruth.Positive {
+					t.predictedPositiveCorrect++
+				}
+			}
+		}
+	}
+
+	report := Report{Attribute: attribute}
+	rate := func(n, d int) float64 {
+		if d == 0 {
+			return 0
+		}
+		return float64(n) / float64(d)
+	}
+
+	for _, g := range order {
+		t := tallies[g]
+		report.DemographicParity = append(report.DemographicParity, GroupRate{
+			Group: g, Rate: rate(t.approved, t.total), Count: t.total,
+		})
+	}
+	report.DisparateImpact = m.disparities(report.DemographicParity, order)
+
+	if haveGroundTruth {
+		for _, g := range order {
+			t := tallies[g]
+			report.EqualOpportunity = append(report.EqualOpportunity, GroupRate{
+				Group: g, Rate: rate(t.actualPositiveApproved, t.actualPositive), Count: t.actualPositive,
+			})
+			report.FalsePositiveRate = append(report.FalsePositiveRate, GroupRate{
+				Group: g, Rate: rate(t.actualNegativeApproved, t.actualNegative), Count: t.actualNegative,
+			})
+			report.PredictiveParity = append(report.PredictiveParity, GroupRate{
+				Group: g, Rate: rate(t.predictedPositiveCorrect, t.predictedPositive), Count: t.predictedPositive,
+			})
+		}
+		report.EqualOpportunityGap = m.disparities(report.EqualOpportunity, order)
+		report.FalsePositiveRateGap = m.disparities(report.FalsePositiveRate, order)
+		report.PredictiveParityGap = m.disparities(report.PredictiveParity, order)
+	}
+
+	return report
+}
+
+// disparities compares every pair of groups' rates and flags any pair
+// whose ratio of lower-to-higher rate falls below the threshold.
+func (m *Metrics) disparities(rates []GroupRate, order []string) []Disparity {
+	byGroup := map[string]float64{}
+	for _, r := range rates {
+		byGroup[r.Group] = r.Rate
+	}
+	threshold := m.threshold()
+	var out []Disparity
+	for i := 0; i < len(order); i++ {
+		for j := i + 1; j < len(order); j++ {
+			a, b := order[i], order[j]
+			rateA, rateB := byGroup[a], byGroup[b]
+			lo, hi := rateA, rateB
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			var ratio float64
+			if hi > 0 {
+				ratio = lo / hi
+			}
+			out = append(out, Disparity{
+				GroupA: a, GroupB: b, Ratio: ratio,
+				Threshold: threshold, Flagged: hi > 0 && ratio < threshold,
+			})
+		}
+	}
+	return out
+}
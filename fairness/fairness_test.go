@@ -0,0 +1,72 @@
+package fairness
+
+import "testing"
+
+func TestComputeDemographicParityNoDisparity(t *testing.T) {
+	groups := map[string]string{"Alice": "a", "Bob": "a", "Carol": "b", "Dave": "b"}
+	extractor := func(p PersonData) map[string]string {
+		return map[string]string{"cohort": groups[p.Name]}
+	}
+
+	logs := []DecisionLog{
+		{Input: PersonData{Name: "Alice"}, Decision: ApprovedDecision},
+		{Input: PersonData{Name: "Bob"}, Decision: "DENIED"},
+		{Input: PersonData{Name: "Carol"}, Decision: ApprovedDecision},
+		{Input: PersonData{Name: "Dave"}, Decision: "DENIED"},
+	}
+
+	report := NewMetrics(extractor).Compute("cohort", logs)
+
+	if len(report.DemographicParity) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(report.DemographicParity))
+	}
+	for _, d := range report.DisparateImpact {
+		if d.Flagged {
+			t.Errorf("expected no disparity between %s and %s, got ratio %v", d.GroupA, d.GroupB, d.Ratio)
+		}
+	}
+}
+
+func TestComputeFlagsDisparateImpact(t *testing.T) {
+	groups := map[string]string{"Alice": "majority", "Bob": "majority", "Carol": "minority", "Dave": "minority"}
+	extractor := func(p PersonData) map[string]string {
+		return map[string]string{"race": groups[p.Name]}
+	}
+
+	logs := []DecisionLog{
+		{Input: PersonData{Name: "Alice"}, Decision: ApprovedDecision},
+		{Input: PersonData{Name: "Bob"}, Decision: ApprovedDecision},
+		{Input: PersonData{Name: "Carol"}, Decision: "DENIED"},
+		{Input: PersonData{Name: "Dave"}, Decision: "DENIED"},
+	}
+
+	report := NewMetrics(extractor).Compute("race", logs)
+
+	found := false
+	for _, d := range report.DisparateImpact {
+		found = true
+		if !d.Flagged {
+			t.Errorf("expected disparity between %s and %s to be flagged, ratio=%v", d.GroupA, d.GroupB, d.Ratio)
+		}
+	}
+	if !found {
+		t.Fatal("expected at least one disparity comparison")
+	}
+}
+
+func TestComputeEqualOpportunityRequiresGroundTruth(t *testing.T) {
+	extractor := func(p PersonData) map[string]string { return map[string]string{"race": "a"} }
+	logs := []DecisionLog{{Input: PersonData{Name: "Alice"}, Decision: ApprovedDecision}}
+
+	report := NewMetrics(extractor).Compute("race", logs)
+	if report.EqualOpportunity != nil {
+		t.Fatalf("expected no equal opportunity rates without ground truth, got %v", report.EqualOpportunity)
+	}
+
+	positive := true
+	logs[0].GroundTruth = &GroundTruth{Positive: positive}
+	report = NewMetrics(extractor).Compute("race", logs)
+	if len(report.EqualOpportunity) != 1 {
+		t.Fatalf("expected 1 equal opportunity rate once ground truth is present, got %d", len(report.EqualOpportunity))
+	}
+}
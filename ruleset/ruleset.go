@@ -0,0 +1,90 @@
+// Package ruleset resolves conflicting fairness rules by explicit
+// priority, following Casbin's explicit-priority pattern: every rule
+// carries a numeric Priority and an Effect, and when several rules match
+// the same decision the highest-priority match wins outright - it does
+// not matter whether that rule allows, denies, or warns. A tie at the
+// highest priority falls through to the RuleSet's DefaultEffect.
+package ruleset
+
+// Effect is the outcome a matching rule produces.
+type Effect string
+
+const (
+	EffectAllow Effect = "allow"
+	EffectDeny  Effect = "deny"
+	EffectWarn  Effect = "warn"
+)
+
+// DefaultEffectFallback is used when a RuleSet doesn't set DefaultEffect.
+const DefaultEffectFallback = EffectWarn
+
+// Context is the decision a rule is evaluated against: who the subject
+// is (e.g. "candidate", "loan_application"), what resource it concerns,
+// and what action is being taken (e.g. "hire", "approve").
+type Context struct {
+	Subject  string
+	Resource string
+	Action   string
+}
+
+// Matcher reports whether a rule applies to a decision Context.
+type Matcher func(Context) bool
+
+// Rule is one fairness policy: a priority, the effect it produces when it
+// matches, and the matcher that decides whether it applies.
+type Rule struct {
+	ID       string
+	Priority int
+	Effect   Effect
+	Match    Matcher
+}
+
+// RuleSet is an ordered collection of rules plus the effect to fall back
+// to when no rule matches, or when the highest-priority matches tie.
+type RuleSet struct {
+	Rules         []Rule
+	DefaultEffect Effect
+}
+
+// Resolution is the outcome of evaluating a RuleSet against a Context,
+// identifying which rule (if any) produced it.
+type Resolution struct {
+	Effect   Effect
+	RuleID   string
+	Priority int
+}
+
+// Evaluate finds every rule that matches ctx and returns the Resolution
+// from the single highest-priority match. If the highest priority is
+// shared by more than one matching rule, the result is ambiguous and
+// Evaluate falls through to DefaultEffect instead of guessing.
+func (rs *RuleSet) Evaluate(ctx Context) Resolution {
+	var best *Rule
+	tied := false
+
+	for i := range rs.Rules {
+		r := &rs.Rules[i]
+		if r.Match == nil || !r.Match(ctx) {
+			continue
+		}
+		switch {
+		case best == nil || r.Priority > best.Priority:
+			best = r
+			tied = false
+		case r.Priority == best.Priority:
+			tied = true
+		}
+	}
+
+	if best == nil || tied {
+		return Resolution{Effect: rs.defaultEffect()}
+	}
+	return Resolution{Effect: best.Effect, RuleID: best.ID, Priority: best.Priority}
+}
+
+func (rs *RuleSet) defaultEffect() Effect {
+	if rs.DefaultEffect == "" {
+		return DefaultEffectFallback
+	}
+	return rs.DefaultEffect
+}
@@ -0,0 +1,59 @@
+package ruleset
+
+import "testing"
+
+func byAction(action string) Matcher {
+	return func(ctx Context) bool { return ctx.Action == action }
+}
+
+func TestEvaluateHighestPriorityWins(t *testing.T) {
+	rs := &RuleSet{
+		DefaultEffect: EffectAllow,
+		Rules: []Rule{
+			{ID: "deny-protected-attribute", Priority: 10, Effect: EffectDeny, Match: byAction("hire")},
+			{ID: "allow-age-for-safety-critical", Priority: 1, Effect: EffectAllow, Match: byAction("hire")},
+		},
+	}
+
+	resolution := rs.Evaluate(Context{Subject: "candidate", Action: "hire"})
+	if resolution.Effect != EffectDeny || resolution.RuleID != "deny-protected-attribute" {
+		t.Fatalf("expected the priority-10 deny rule to win, got %+v", resolution)
+	}
+}
+
+func TestEvaluateNoMatchFallsBackToDefault(t *testing.T) {
+	rs := &RuleSet{
+		DefaultEffect: EffectAllow,
+		Rules: []Rule{
+			{ID: "deny-protected-attribute", Priority: 10, Effect: EffectDeny, Match: byAction("hire")},
+		},
+	}
+
+	resolution := rs.Evaluate(Context{Subject: "loan_application", Action: "approve"})
+	if resolution.Effect != EffectAllow || resolution.RuleID != "" {
+		t.Fatalf("expected fallback to default effect with no rule ID, got %+v", resolution)
+	}
+}
+
+func TestEvaluateTiedPriorityFallsBackToDefault(t *testing.T) {
+	rs := &RuleSet{
+		DefaultEffect: EffectWarn,
+		Rules: []Rule{
+			{ID: "deny-race", Priority: 5, Effect: EffectDeny, Match: byAction("approve")},
+			{ID: "allow-income", Priority: 5, Effect: EffectAllow, Match: byAction("approve")},
+		},
+	}
+
+	resolution := rs.Evaluate(Context{Subject: "loan_application", Action: "approve"})
+	if resolution.Effect != EffectWarn || resolution.RuleID != "" {
+		t.Fatalf("expected a tie at priority 5 to fall back to the default effect, got %+v", resolution)
+	}
+}
+
+func TestEvaluateDefaultsToWarnWhenUnset(t *testing.T) {
+	rs := &RuleSet{}
+	resolution := rs.Evaluate(Context{Action: "approve"})
+	if resolution.Effect != EffectWarn {
+		t.Fatalf("expected DefaultEffectFallback (warn) when DefaultEffect is unset, got %v", resolution.Effect)
+	}
+}
@@ -0,0 +1,147 @@
+// Package schema lets maintainers declare, in JSON or YAML, which struct
+// fields across the example files are protected attributes (e.g.
+// Candidate.Race), which are permitted job/risk-relevant features (e.g.
+// Candidate.Experience), and how a field can act as a proxy for a
+// protected one it doesn't name directly (e.g. Person.ZipCode proxying
+// for race). A rule engine loads a Schema and asks IsProtected or
+// ProxyChain instead of hard-coding field names.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// AttributeKind says whether a field is declared protected or explicitly
+// permitted.
+type AttributeKind string
+
+const (
+	KindProtected AttributeKind = "protected"
+	KindPermitted AttributeKind = "permitted"
+)
+
+// Type describes the shape of an attribute's values.
+type Type string
+
+const (
+	TypeCategorical Type = "categorical"
+	TypeNumeric     Type = "numeric"
+	TypeHashed      Type = "hashed"
+)
+
+// FieldRef identifies one struct field by its declared Go type name and
+// field name, e.g. {TypeName: "Candidate", FieldName: "Race"}.
+type FieldRef struct {
+	TypeName  string `json:"type"`
+	FieldName string `json:"field"`
+}
+
+func (r FieldRef) String() string {
+	return fmt.Sprintf("%s.%s", r.TypeName, r.FieldName)
+}
+
+// Attribute is one declared field along with what kind of attribute it
+// is, its value type, and - for fields that merely correlate with a
+// protected attribute rather than naming it - what it is a proxy of.
+type Attribute struct {
+	FieldRef
+	Kind                  AttributeKind `json:"kind"`
+	Type                  Type          `json:"value_type"`
+	ProxyOf               *FieldRef     `json:"proxy_of,omitempty"`
+	RequiresJustification bool          `json:"requires_justification,omitempty"`
+}
+
+// Schema is a named collection of attribute declarations, typically one
+// per regulatory domain (e.g. "us-employment", "us-lending").
+type Schema struct {
+	Name       string      `json:"name"`
+	Attributes []Attribute `json:"attributes"`
+
+	byField map[FieldRef]Attribute
+}
+
+// Load parses a JSON-encoded schema and indexes its attributes for fast
+// lookup.
+func Load(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	s.index()
+	return &s, nil
+}
+
+// LoadYAML parses a YAML-encoded schema, using the same `json:` struct
+// tags as Load, and indexes its attributes for fast lookup.
+func LoadYAML(data []byte) (*Schema, error) {
+	var s Schema
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	s.index()
+	return &s, nil
+}
+
+// LoadFile reads and parses a schema from a JSON or YAML file on disk,
+// chosen by its extension (.yaml/.yml vs .json).
+func LoadFile(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return LoadYAML(data)
+	default:
+		return Load(data)
+	}
+}
+
+func (s *Schema) index() {
+	s.byField = make(map[FieldRef]Attribute, len(s.Attributes))
+	for _, a := range s.Attributes {
+		s.byField[a.FieldRef] = a
+	}
+}
+
+// Attribute returns the declared attribute for typeName.fieldName, if any.
+func (s *Schema) Attribute(typeName, fieldName string) (Attribute, bool) {
+	a, ok := s.byField[FieldRef{TypeName: typeName, FieldName: fieldName}]
+	return a, ok
+}
+
+// IsProtected reports whether typeName.fieldName is declared protected.
+func (s *Schema) IsProtected(typeName, fieldName string) bool {
+	a, ok := s.Attribute(typeName, fieldName)
+	return ok && a.Kind == KindProtected
+}
+
+// ProxyChain walks the ProxyOf relation starting at typeName.fieldName,
+// returning the field itself followed by every attribute it proxies for,
+// transitively. It stops at the first attribute with no ProxyOf, or if a
+// cycle is detected, at the point the cycle would repeat.
+func (s *Schema) ProxyChain(typeName, fieldName string) []FieldRef {
+	ref := FieldRef{TypeName: typeName, FieldName: fieldName}
+	chain := []FieldRef{ref}
+	seen := map[FieldRef]bool{ref: true}
+
+	for {
+		a, ok := s.byField[ref]
+		if !ok || a.ProxyOf == nil {
+			return chain
+		}
+		next := *a.ProxyOf
+		if seen[next] {
+			return chain
+		}
+		chain = append(chain, next)
+		seen[next] = true
+		ref = next
+	}
+}
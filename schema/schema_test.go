@@ -0,0 +1,91 @@
+package schema
+
+import "testing"
+
+func TestLoadUSEmploymentSchema(t *testing.T) {
+	s, err := LoadFile("testdata/us_employment.json")
+	if err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+
+	protected := []FieldRef{
+		{TypeName: "Candidate", FieldName: "Age"},
+		{TypeName: "Candidate", FieldName: "Gender"},
+		{TypeName: "Candidate", FieldName: "Race"},
+	}
+	for _, f := range protected {
+		if !s.IsProtected(f.TypeName, f.FieldName) {
+			t.Errorf("expected %s to be protected", f)
+		}
+	}
+
+	permitted := []FieldRef{
+		{TypeName: "Candidate", FieldName: "Experience"},
+		{TypeName: "Candidate", FieldName: "Skills"},
+	}
+	for _, f := range permitted {
+		if s.IsProtected(f.TypeName, f.FieldName) {
+			t.Errorf("expected %s to be permitted, not protected", f)
+		}
+	}
+}
+
+func TestLoadUSEmploymentSchemaFromYAML(t *testing.T) {
+	s, err := LoadFile("testdata/us_employment.yaml")
+	if err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+
+	if !s.IsProtected("Candidate", "Race") {
+		t.Error("expected Candidate.Race to be protected")
+	}
+	if s.IsProtected("Candidate", "Experience") {
+		t.Error("expected Candidate.Experience to be permitted, not protected")
+	}
+}
+
+func TestLoadUSLendingSchemaAndProxyChain(t *testing.T) {
+	s, err := LoadFile("testdata/us_lending.json")
+	if err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+
+	if !s.IsProtected("LoanApplication", "Race") {
+		t.Error("expected LoanApplication.Race to be protected")
+	}
+	if s.IsProtected("LoanApplication", "Income") {
+		t.Error("expected LoanApplication.Income to be permitted, not protected")
+	}
+
+	chain := s.ProxyChain("Person", "ZipCode")
+	want := []FieldRef{
+		{TypeName: "Person", FieldName: "ZipCode"},
+		{TypeName: "LoanApplication", FieldName: "Race"},
+	}
+	if len(chain) != len(want) {
+		t.Fatalf("ProxyChain length = %d, want %d (%v)", len(chain), len(want), chain)
+	}
+	for i := range want {
+		if chain[i] != want[i] {
+			t.Errorf("ProxyChain[%d] = %v, want %v", i, chain[i], want[i])
+		}
+	}
+
+	attr, ok := s.Attribute("Person", "ZipCode")
+	if !ok || !attr.RequiresJustification {
+		t.Error("expected Person.ZipCode to require justification")
+	}
+}
+
+func TestProxyChainStopsAtNonProxyField(t *testing.T) {
+	s, err := Load([]byte(`{"name":"t","attributes":[
+		{"type":"Candidate","field":"Race","kind":"protected","value_type":"categorical"}
+	]}`))
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	chain := s.ProxyChain("Candidate", "Race")
+	if len(chain) != 1 || chain[0].FieldName != "Race" {
+		t.Errorf("expected a single-element chain for a non-proxy field, got %v", chain)
+	}
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/TavoAI/tavo-rules/enforcement"
+	"github.com/TavoAI/tavo-rules/ruleset"
+)
+
+// SAFE: AI Ethics - Transparency and accountability
+
+type PersonData struct {
+	Name        string
+	Income      float64
+	CreditScore int
+}
+
+type PredictionResult struct {
+	Decision    string
+	Confidence  float64
+	Explanation string
+	Timestamp   time.Time
+}
+
+type DecisionLog struct {
+	Input     PersonData
+	Output    PredictionResult
+	Timestamp time.Time
+
+	// EnforcementScope and EnforcementAction are only set when a rule
+	// engine wrapped the call that produced this entry (see
+	// EnforcingSystem below); they record which runtime scope was active
+	// and which action the matching rule's enforcement policy resolved to.
+	EnforcementScope  string
+	EnforcementAction enforcement.Action
+
+	// RuleID and RulePriority are only set when AuditableSystem.Rules is
+	// configured; they record which ruleset.Rule produced this decision,
+	// so the audit trail explains exactly which policy won.
+	RuleID       string
+	RulePriority int
+}
+
+// SAFE: Transparent prediction system
+type TransparentPredictor struct {
+	auditLog []DecisionLog
+}
+
+func (p *TransparentPredictor) PredictOutcome(input PersonData) PredictionResult {
+	score := rand.Float64()
+
+	var decision, explanation string
+	if score > 0.7 {
+		decision = "APPROVED"
+		explanation = "High confidence score indicates low risk"
+	} else if score > 0.4 {
+		decision = "REVIEW"
+		explanation = "Moderate confidence requires human review"
+	} else {
+		decision = "DENIED"
+		explanation = "Low confidence indicates potential risk"
+	}
+
+	result := PredictionResult{
+		Decision:    decision,
+		Confidence:  score,
+		Explanation: explanation,
+		Timestamp:   time.Now(),
+	}
+
+	// SAFE: Comprehensive audit logging
+	p.auditLog = append(p.auditLog, DecisionLog{
+		Input:     input,
+		Output:    result,
+		Timestamp: time.Now(),
+	})
+
+	// SAFE: Limit audit log size
+	if len(p.auditLog) > 10000 {
+		p.auditLog = p.auditLog[1:]
+	}
+
+	return result
+}
+
+func (p *TransparentPredictor) GetAuditLog() []DecisionLog {
+	return append([]DecisionLog{}, p.auditLog...)
+}
+
+// SAFE: Privacy-compliant data handling
+type PrivacyProtector struct {
+	collectedData []AnonymizedData
+}
+
+type AnonymizedData struct {
+	HashedName  string
+	Income      float64
+	CreditScore int
+}
+
+func (p *PrivacyProtector) CollectData(person PersonData, consentGiven bool) error {
+	if !consentGiven {
+		return fmt.Errorf("user consent required")
+	}
+
+	// SAFE: Data minimization and anonymization
+	anonymized := AnonymizedData{
+		HashedName:  hashString(person.Name),
+		Income:      person.Income,
+		CreditScore: person.CreditScore,
+	}
+
+	p.collectedData = append(p.collectedData, anonymized)
+	log.Printf("Collected anonymized data for user")
+
+	return nil
+}
+
+func (p *PrivacyProtector) ProcessDataLocally() {
+	// SAFE: Local processing only, no sharing
+	for _, data := range p.collectedData {
+		log.Printf("Processing data: income=%.0f, credit=%d",
+			data.Income, data.CreditScore)
+	}
+}
+
+// SAFE: Auditable decision system
+type AuditableSystem struct {
+	predictor TransparentPredictor
+
+	// Rules, if set, is consulted before every decision becomes final;
+	// the firing rule's ID and priority are attached to the audit log so
+	// the trail explains exactly which policy produced the outcome.
+	Rules *ruleset.RuleSet
+}
+
+func (s *AuditableSystem) ProcessApplication(application PersonData) string {
+	log.Printf("Processing application for: %s", application.Name)
+
+	result := s.predictor.PredictOutcome(application)
+
+	var resolution ruleset.Resolution
+	if s.Rules != nil {
+		resolution = s.Rules.Evaluate(ruleset.Context{
+			Subject:  "loan_application",
+			Resource: application.Name,
+			Action:   "approve",
+		})
+		if resolution.Effect == ruleset.EffectDeny {
+			result.Decision = "DENIED"
+			result.Explanation = fmt.Sprintf("denied by rule %s (priority %d)", resolution.RuleID, resolution.Priority)
+		}
+		if entries := s.predictor.auditLog; len(entries) > 0 {
+			last := &entries[len(entries)-1]
+			last.Output = result
+			last.RuleID = resolution.RuleID
+			last.RulePriority = resolution.Priority
+		}
+	}
+
+	// SAFE: Detailed logging
+	log.Printf("Decision for %s: %s (confidence: %.2f) - %s",
+		application.Name, result.Decision, result.Confidence, result.Explanation)
+
+	return result.Decision
+}
+
+func (s *AuditableSystem) GetAuditTrail() []DecisionLog {
+	return s.predictor.GetAuditLog()
+}
+
+// EnforcingSystem wraps an AuditableSystem so a bias/ethics rule (e.g.
+// "uses protected attribute in decision") can be rolled out as a warning
+// in one runtime scope while denying outright in another, without the
+// rule itself changing. See enforcement.RuleEnforcement.
+type EnforcingSystem struct {
+	System   *AuditableSystem
+	Rule     enforcement.RuleEnforcement
+	Scope    string
+	Counters *enforcement.Counters
+}
+
+// ProcessApplication resolves the wrapped rule's enforcement action for
+// this system's scope, records it against Counters, and either blocks the
+// application outright (deny) or lets it through to the wrapped system
+// (warn, dryrun) - tagging the resulting DecisionLog entry with the scope
+// and action that applied either way.
+func (e *EnforcingSystem) ProcessApplication(application PersonData) string {
+	action := enforcement.ResolveAction(e.Rule, e.Scope)
+	if e.Counters != nil {
+		e.Counters.Record(e.Scope, action)
+	}
+
+	if action == enforcement.ActionDeny {
+		log.Printf("Enforcement denied application for %s: rule %s (scope=%s)",
+			application.Name, e.Rule.RuleID, e.Scope)
+		e.System.predictor.auditLog = append(e.System.predictor.auditLog, DecisionLog{
+			Input:             application,
+			Output:            PredictionResult{Decision: "DENIED", Explanation: fmt.Sprintf("denied by rule %s", e.Rule.RuleID), Timestamp: time.Now()},
+			Timestamp:         time.Now(),
+			EnforcementScope:  e.Scope,
+			EnforcementAction: action,
+		})
+		return "DENIED"
+	}
+
+	if action == enforcement.ActionWarn {
+		log.Printf("WARNING: rule %s matched for %s (scope=%s)", e.Rule.RuleID, application.Name, e.Scope)
+	}
+
+	decision := e.System.ProcessApplication(application)
+
+	if auditLog := e.System.predictor.auditLog; len(auditLog) > 0 {
+		auditLog[len(auditLog)-1].EnforcementScope = e.Scope
+		auditLog[len(auditLog)-1].EnforcementAction = action
+	}
+
+	return decision
+}
+
+func hashString(input string) string {
+	hash := sha256.Sum256([]byte(input))
+	return fmt.Sprintf("%x", hash)
+}
+
+func main() {
+	rand.Seed(time.Now().UnixNano())
+
+	system := &AuditableSystem{}
+	protector := &PrivacyProtector{}
+
+	people := []PersonData{
+		{"Alice", 50000, 750},
+		{"Bob", 75000, 800},
+	}
+
+	for _, person := range people {
+		// SAFE: Require explicit consent
+		err := protector.CollectData(person, true)
+		if err != nil {
+			log.Printf("Failed to collect data: %v", err)
+			continue
+		}
+
+		decision := system.ProcessApplication(person)
+		fmt.Printf("Decision for %s: %s\n", person.Name, decision)
+	}
+
+	// SAFE: Local processing only
+	protector.ProcessDataLocally()
+
+	// SAFE: Audit trail available
+	auditTrail := system.GetAuditTrail()
+	fmt.Printf("Total decisions logged: %d\n", len(auditTrail))
+
+	// SAFE: Roll the "uses protected attribute" rule out as a warning in
+	// audit, while the same rule would deny in CI.
+	rule := enforcement.RuleEnforcement{
+		RuleID: "uses-protected-attribute",
+		ScopedActions: []enforcement.ScopedAction{
+			{Scope: "ci", Action: enforcement.ActionDeny},
+			{Scope: "webhook", Action: enforcement.ActionDryRun},
+		},
+		DefaultAction: enforcement.ActionWarn,
+	}
+	counters := enforcement.NewCounters()
+	enforcingSystem := &EnforcingSystem{System: system, Rule: rule, Scope: "audit", Counters: counters}
+	enforcingSystem.ProcessApplication(PersonData{Name: "Carol", Income: 62000, CreditScore: 710})
+	fmt.Printf("Enforcement counters: %+v\n", counters.Snapshot())
+
+	// SAFE: A broad rule blocking protected-attribute use outranks a
+	// narrower one permitting it for a specific exception, so the audit
+	// trail always reflects the higher-priority policy's verdict.
+	rankedSystem := &AuditableSystem{
+		Rules: &ruleset.RuleSet{
+			DefaultEffect: ruleset.EffectAllow,
+			Rules: []ruleset.Rule{
+				{
+					ID: "deny-protected-attribute-use", Priority: 10, Effect: ruleset.EffectDeny,
+					Match: func(ctx ruleset.Context) bool { return ctx.Action == "approve" },
+				},
+				{
+					ID: "allow-age-for-safety-critical-roles", Priority: 1, Effect: ruleset.EffectAllow,
+					Match: func(ctx ruleset.Context) bool { return ctx.Action == "approve" },
+				},
+			},
+		},
+	}
+	decision := rankedSystem.ProcessApplication(PersonData{Name: "Dana", Income: 58000, CreditScore: 690})
+	fmt.Printf("Decision for Dana: %s\n", decision)
+}
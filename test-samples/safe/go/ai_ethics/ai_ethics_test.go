@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/TavoAI/tavo-rules/enforcement"
+	"github.com/TavoAI/tavo-rules/ruleset"
+)
+
+func TestEnforcingSystemDeniesInCIScope(t *testing.T) {
+	rule := enforcement.RuleEnforcement{
+		RuleID: "uses-protected-attribute",
+		ScopedActions: []enforcement.ScopedAction{
+			{Scope: "ci", Action: enforcement.ActionDeny},
+		},
+		DefaultAction: enforcement.ActionWarn,
+	}
+	system := &AuditableSystem{}
+	counters := enforcement.NewCounters()
+	enforcingSystem := &EnforcingSystem{System: system, Rule: rule, Scope: "ci", Counters: counters}
+
+	decision := enforcingSystem.ProcessApplication(PersonData{Name: "Dana", Income: 58000, CreditScore: 690})
+	if decision != "DENIED" {
+		t.Fatalf("expected ci scope to deny, got %q", decision)
+	}
+
+	trail := system.GetAuditTrail()
+	if len(trail) != 1 {
+		t.Fatalf("expected one audit entry, got %d", len(trail))
+	}
+	if trail[0].EnforcementScope != "ci" || trail[0].EnforcementAction != enforcement.ActionDeny {
+		t.Errorf("expected audit entry tagged ci/deny, got scope=%q action=%q", trail[0].EnforcementScope, trail[0].EnforcementAction)
+	}
+	if counters.Snapshot()["ci"][enforcement.ActionDeny] != 1 {
+		t.Errorf("expected counters to record one ci/deny")
+	}
+}
+
+func TestEnforcingSystemWarnsInAuditScope(t *testing.T) {
+	rule := enforcement.RuleEnforcement{
+		RuleID:        "uses-protected-attribute",
+		DefaultAction: enforcement.ActionWarn,
+	}
+	system := &AuditableSystem{}
+	enforcingSystem := &EnforcingSystem{System: system, Rule: rule, Scope: "audit", Counters: enforcement.NewCounters()}
+
+	enforcingSystem.ProcessApplication(PersonData{Name: "Eli", Income: 47000, CreditScore: 640})
+
+	trail := system.GetAuditTrail()
+	if len(trail) != 1 {
+		t.Fatalf("expected one audit entry, got %d", len(trail))
+	}
+	if trail[0].EnforcementAction != enforcement.ActionWarn {
+		t.Errorf("expected warn action recorded, got %q", trail[0].EnforcementAction)
+	}
+}
+
+func TestProcessApplicationHighestPriorityRuleWins(t *testing.T) {
+	system := &AuditableSystem{
+		Rules: &ruleset.RuleSet{
+			DefaultEffect: ruleset.EffectAllow,
+			Rules: []ruleset.Rule{
+				{
+					ID: "deny-protected-attribute-use", Priority: 10, Effect: ruleset.EffectDeny,
+					Match: func(ctx ruleset.Context) bool { return ctx.Action == "approve" },
+				},
+				{
+					ID: "allow-age-for-safety-critical-roles", Priority: 1, Effect: ruleset.EffectAllow,
+					Match: func(ctx ruleset.Context) bool { return ctx.Action == "approve" },
+				},
+			},
+		},
+	}
+
+	decision := system.ProcessApplication(PersonData{Name: "Fay", Income: 51000, CreditScore: 700})
+	if decision != "DENIED" {
+		t.Fatalf("expected the priority-10 deny rule to win, got %q", decision)
+	}
+
+	trail := system.GetAuditTrail()
+	if len(trail) != 1 {
+		t.Fatalf("expected one audit entry, got %d", len(trail))
+	}
+	if trail[0].RuleID != "deny-protected-attribute-use" || trail[0].RulePriority != 10 {
+		t.Errorf("expected audit entry to attribute the decision to the priority-10 rule, got %+v", trail[0])
+	}
+}
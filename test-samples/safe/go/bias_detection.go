@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"log"
-	"time"
 )
 
 // SAFE: Bias Detection - Fair and compliant systems
@@ -158,174 +157,3 @@ func main() {
 		fmt.Printf("Application %s: decision=%s\n", app.Name, decision)
 	}
 }
-EOF && cat > ai_ethics.go << 'EOF'
-package main
-
-import (
-	"crypto/sha256"
-	"fmt"
-	"log"
-	"math/rand"
-	"time"
-)
-
-// SAFE: AI Ethics - Transparency and accountability
-
-type PersonData struct {
-	Name        string
-	Income      float64
-	CreditScore int
-}
-
-type PredictionResult struct {
-	Decision    string
-	Confidence  float64
-	Explanation string
-	Timestamp   time.Time
-}
-
-type DecisionLog struct {
-	Input     PersonData
-	Output    PredictionResult
-	Timestamp time.Time
-}
-
-// SAFE: Transparent prediction system
-type TransparentPredictor struct {
-	auditLog []DecisionLog
-}
-
-func (p *TransparentPredictor) PredictOutcome(input PersonData) PredictionResult {
-	score := rand.Float64()
-	
-	var decision, explanation string
-	if score > 0.7 {
-		decision = "APPROVED"
-		explanation = "High confidence score indicates low risk"
-	} else if score > 0.4 {
-		decision = "REVIEW"
-		explanation = "Moderate confidence requires human review"
-	} else {
-		decision = "DENIED"
-		explanation = "Low confidence indicates potential risk"
-	}
-	
-	result := PredictionResult{
-		Decision:    decision,
-		Confidence:  score,
-		Explanation: explanation,
-		Timestamp:   time.Now(),
-	}
-	
-	// SAFE: Comprehensive audit logging
-	p.auditLog = append(p.auditLog, DecisionLog{
-		Input:     input,
-		Output:    result,
-		Timestamp: time.Now(),
-	})
-	
-	// SAFE: Limit audit log size
-	if len(p.auditLog) > 10000 {
-		p.auditLog = p.auditLog[1:]
-	}
-	
-	return result
-}
-
-func (p *TransparentPredictor) GetAuditLog() []DecisionLog {
-	return append([]DecisionLog{}, p.auditLog...)
-}
-
-// SAFE: Privacy-compliant data handling
-type PrivacyProtector struct {
-	collectedData []AnonymizedData
-}
-
-type AnonymizedData struct {
-	HashedName  string
-	Income      float64
-	CreditScore int
-}
-
-func (p *PrivacyProtector) CollectData(person PersonData, consentGiven bool) error {
-	if !consentGiven {
-		return fmt.Errorf("user consent required")
-	}
-	
-	// SAFE: Data minimization and anonymization
-	anonymized := AnonymizedData{
-		HashedName:  hashString(person.Name),
-		Income:      person.Income,
-		CreditScore: person.CreditScore,
-	}
-	
-	p.collectedData = append(p.collectedData, anonymized)
-	log.Printf("Collected anonymized data for user")
-	
-	return nil
-}
-
-func (p *PrivacyProtector) ProcessDataLocally() {
-	// SAFE: Local processing only, no sharing
-	for _, data := range p.collectedData {
-		log.Printf("Processing data: income=%.0f, credit=%d", 
-			data.Income, data.CreditScore)
-	}
-}
-
-// SAFE: Auditable decision system
-type AuditableSystem struct {
-	predictor TransparentPredictor
-}
-
-func (s *AuditableSystem) ProcessApplication(application PersonData) string {
-	log.Printf("Processing application for: %s", application.Name)
-	
-	result := s.predictor.PredictOutcome(application)
-	
-	// SAFE: Detailed logging
-	log.Printf("Decision for %s: %s (confidence: %.2f) - %s",
-		application.Name, result.Decision, result.Confidence, result.Explanation)
-	
-	return result.Decision
-}
-
-func (s *AuditableSystem) GetAuditTrail() []DecisionLog {
-	return s.predictor.GetAuditLog()
-}
-
-func hashString(input string) string {
-	hash := sha256.Sum256([]byte(input))
-	return fmt.Sprintf("%x", hash)
-}
-
-func main() {
-	rand.Seed(time.Now().UnixNano())
-	
-	system := &AuditableSystem{}
-	protector := &PrivacyProtector{}
-	
-	people := []PersonData{
-		{"Alice", 50000, 750},
-		{"Bob", 75000, 800},
-	}
-	
-	for _, person := range people {
-		// SAFE: Require explicit consent
-		err := protector.CollectData(person, true)
-		if err != nil {
-			log.Printf("Failed to collect data: %v", err)
-			continue
-		}
-		
-		decision := system.ProcessApplication(person)
-		fmt.Printf("Decision for %s: %s\n", person.Name, decision)
-	}
-	
-	// SAFE: Local processing only
-	protector.ProcessDataLocally()
-	
-	// SAFE: Audit trail available
-	auditTrail := system.GetAuditTrail()
-	fmt.Printf("Total decisions logged: %d\n", len(auditTrail))
-}
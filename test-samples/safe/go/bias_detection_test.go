@@ -0,0 +1,176 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/TavoAI/tavo-rules/fairness"
+)
+
+// biasedShouldHire reproduces the gender-based bar from the VULNERABLE
+// counterpart file (`candidate.Gender == "female"` draws a higher
+// experience bar than everyone else) so the tests below can confirm the
+// fairness package actually flags that pattern, not just that it stays
+// quiet on fair input.
+func biasedShouldHire(gender string, experience int) bool {
+	if gender == "female" {
+		return experience > 5
+	}
+	return experience > 3
+}
+
+// biasedApproveLoan reproduces the race-based auto-denial from the
+// VULNERABLE counterpart file (`application.Race == "minority"` is
+// denied outright, regardless of income).
+func biasedApproveLoan(race string, income float64) string {
+	if race == "minority" {
+		return "DENIED"
+	}
+	if income > 50000 {
+		return "APPROVED"
+	}
+	return "DENIED"
+}
+
+func TestShouldHireStaysWithinDisparityBounds(t *testing.T) {
+	// Each cohort has its own mix of qualified and unqualified candidates
+	// - not identical pairs - so a passing assertion actually exercises
+	// shouldHire's merit-only scoring rather than being vacuously true.
+	women := []Candidate{
+		{"Alice", 6, "masters", []string{"python", "sql", "aws"}},
+		{"Carol", 3, "bachelors", []string{"python"}},
+		{"Erin", 2, "associates", nil},
+		{"Grace", 5, "bachelors", []string{"sql", "aws"}},
+		{"Ivy", 4, "bachelors", []string{"sql", "aws"}},
+	}
+	men := []Candidate{
+		{"Bob", 5, "masters", []string{"python", "sql"}},
+		{"Dave", 2, "bachelors", []string{"python"}},
+		{"Frank", 3, "associates", []string{"sql"}},
+		{"Henry", 4, "bachelors", []string{"python", "sql", "aws"}},
+		{"Jack", 6, "bachelors", []string{"sql", "aws"}},
+	}
+
+	group := map[string]string{}
+	for _, c := range women {
+		group[c.Name] = "female"
+	}
+	for _, c := range men {
+		group[c.Name] = "male"
+	}
+	extractor := func(p fairness.PersonData) map[string]string {
+		return map[string]string{"gender": group[p.Name]}
+	}
+
+	var logs []fairness.DecisionLog
+	for _, c := range append(append([]Candidate{}, women...), men...) {
+		decision := "DENIED"
+		if shouldHire(c) {
+			decision = fairness.ApprovedDecision
+		}
+		logs = append(logs, fairness.DecisionLog{
+			Input:    fairness.PersonData{Name: c.Name},
+			Decision: decision,
+		})
+	}
+
+	report := fairness.NewMetrics(extractor).Compute("gender", logs)
+	for _, d := range report.DisparateImpact {
+		if d.Flagged {
+			t.Errorf("shouldHire shows disparate impact between %s and %s: ratio=%.2f", d.GroupA, d.GroupB, d.Ratio)
+		}
+	}
+
+	// Negative control: run the same candidates' experience through the
+	// VULNERABLE file's gender-based bar instead of shouldHire, and
+	// confirm the metric actually catches it.
+	var biasedLogs []fairness.DecisionLog
+	for _, c := range append(append([]Candidate{}, women...), men...) {
+		decision := "DENIED"
+		if biasedShouldHire(group[c.Name], c.Experience) {
+			decision = fairness.ApprovedDecision
+		}
+		biasedLogs = append(biasedLogs, fairness.DecisionLog{
+			Input:    fairness.PersonData{Name: c.Name},
+			Decision: decision,
+		})
+	}
+	biasedReport := fairness.NewMetrics(extractor).Compute("gender", biasedLogs)
+	flagged := false
+	for _, d := range biasedReport.DisparateImpact {
+		if d.Flagged {
+			flagged = true
+		}
+	}
+	if !flagged {
+		t.Error("expected the VULNERABLE file's gender bar to be flagged as disparate impact")
+	}
+}
+
+func TestApproveLoanStaysWithinDisparityBounds(t *testing.T) {
+	minority := []LoanApplication{
+		{"Pat", 80000, 16000, 100, 400, 0},
+		{"Quinn", 50000, 18000, 90, 300, 0},
+		{"Riley", 40000, 20000, 60, 100, 2},
+		{"Sam", 55000, 15000, 95, 350, 0},
+		{"Toni", 35000, 21000, 50, 80, 3},
+	}
+	majority := []LoanApplication{
+		{"Uma", 75000, 18000, 98, 380, 0},
+		{"Victor", 48000, 17000, 92, 320, 1},
+		{"Wendy", 42000, 22000, 55, 90, 2},
+		{"Xavier", 58000, 14000, 96, 360, 0},
+		{"Yara", 33000, 20000, 48, 70, 3},
+	}
+
+	group := map[string]string{}
+	for _, a := range minority {
+		group[a.Name] = "minority"
+	}
+	for _, a := range majority {
+		group[a.Name] = "majority"
+	}
+	extractor := func(p fairness.PersonData) map[string]string {
+		return map[string]string{"race": group[p.Name]}
+	}
+
+	var logs []fairness.DecisionLog
+	for _, app := range append(append([]LoanApplication{}, minority...), majority...) {
+		decision := approveLoan(app)
+		if decision == "REVIEW" {
+			decision = fairness.ApprovedDecision
+		}
+		logs = append(logs, fairness.DecisionLog{
+			Input:    fairness.PersonData{Name: app.Name},
+			Decision: decision,
+		})
+	}
+
+	report := fairness.NewMetrics(extractor).Compute("race", logs)
+	for _, d := range report.DisparateImpact {
+		if d.Flagged {
+			t.Errorf("approveLoan shows disparate impact between %s and %s: ratio=%.2f", d.GroupA, d.GroupB, d.Ratio)
+		}
+	}
+
+	// Negative control: run the same applicants' income through the
+	// VULNERABLE file's race-based auto-denial instead of approveLoan,
+	// and confirm the metric actually catches it.
+	var biasedLogs []fairness.DecisionLog
+	for _, app := range append(append([]LoanApplication{}, minority...), majority...) {
+		decision := biasedApproveLoan(group[app.Name], app.Income)
+		biasedLogs = append(biasedLogs, fairness.DecisionLog{
+			Input:    fairness.PersonData{Name: app.Name},
+			Decision: decision,
+		})
+	}
+	biasedReport := fairness.NewMetrics(extractor).Compute("race", biasedLogs)
+	flagged := false
+	for _, d := range biasedReport.DisparateImpact {
+		if d.Flagged {
+			flagged = true
+		}
+	}
+	if !flagged {
+		t.Error("expected the VULNERABLE file's race-based auto-denial to be flagged as disparate impact")
+	}
+}
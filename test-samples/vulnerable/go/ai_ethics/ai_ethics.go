@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// VULNERABLE: AI Ethics - Lack of transparency and accountability
+
+type PersonData struct {
+	Name           string
+	Age            int
+	SSN            string // VULNERABLE: Social Security Number
+	MedicalHistory string
+	Income         float64
+}
+
+// VULNERABLE: Black-box prediction system
+type BlackBoxPredictor struct{}
+
+func (p *BlackBoxPredictor) PredictOutcome(input PersonData) string {
+	score := rand.Float64()
+
+	// VULNERABLE: Opaque decision logic
+	if score > 0.7 {
+		return "APPROVED"
+	} else if score > 0.4 {
+		return "REVIEW"
+	}
+
+	return "DENIED"
+}
+
+// VULNERABLE: Privacy-violating data collection
+type PrivacyViolator struct {
+	collectedData []PersonData
+}
+
+func (p *PrivacyViolator) CollectData(person PersonData) {
+	p.collectedData = append(p.collectedData, person)
+
+	// VULNERABLE: Share data without consent
+	p.shareWithThirdParties(person)
+}
+
+func (p *PrivacyViolator) shareWithThirdParties(data PersonData) {
+	// VULNERABLE: No consent checking
+	fmt.Printf("Sharing data with advertisers: %+v\n", data)
+}
+
+// VULNERABLE: Unauditable decision system
+type UnauditableSystem struct {
+	predictor BlackBoxPredictor
+}
+
+func (s *UnauditableSystem) ProcessApplication(application PersonData) string {
+	decision := s.predictor.PredictOutcome(application)
+
+	// VULNERABLE: No logging or audit trail
+	return decision
+}
+
+func main() {
+	rand.Seed(time.Now().UnixNano())
+
+	system := &UnauditableSystem{}
+	violator := &PrivacyViolator{}
+
+	// VULNERABLE: Process without audit trail
+	people := []PersonData{
+		{"Alice", 30, "123-45-6789", "Medical data here", 50000},
+		{"Bob", 45, "987-65-4321", "More medical data", 75000},
+	}
+
+	for _, person := range people {
+		decision := system.ProcessApplication(person)
+		fmt.Printf("Decision for %s: %s\n", person.Name, decision)
+
+		// VULNERABLE: Collect and share private data
+		violator.CollectData(person)
+	}
+}